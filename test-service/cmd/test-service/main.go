@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"test-service/internal/server"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+// version, commit, and buildDate are injected at build time via
+// -ldflags "-X main.version=... -X main.commit=... -X main.buildDate=...".
+// See the Makefile for the exact build invocation.
+var (
+	version   = "dev"
+	commit    = "none"
+	buildDate = "unknown"
+)
+
+var (
+	addrFlag    = flag.String("addr", "", "address to listen on, e.g. :8080 or 0.0.0.0:8080")
+	portFlag    = flag.String("port", "", "port to listen on (overridden by -addr)")
+	metricsFlag = flag.Bool("metrics", false, "expose a Prometheus /metrics endpoint and RED instrumentation")
+)
+
+func listenAddr() string {
+	if *addrFlag != "" {
+		return *addrFlag
+	}
+	if *portFlag != "" {
+		return ":" + *portFlag
+	}
+	if v := os.Getenv("HTTP_ADDR"); v != "" {
+		return v
+	}
+	if v := os.Getenv("PORT"); v != "" {
+		return ":" + v
+	}
+	return ":8080"
+}
+
+func metricsEnabled() bool {
+	if *metricsFlag {
+		return true
+	}
+	enabled, _ := strconv.ParseBool(os.Getenv("METRICS_ENABLED"))
+	return enabled
+}
+
+func main() {
+	flag.Parse()
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+	addr := listenAddr()
+	build := server.BuildInfo{Version: version, Commit: commit, BuildDate: buildDate}
+	srv, ready := server.New(addr, logger, build, metricsEnabled())
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		logger.Error("listen error", "error", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		logger.Info("listening", "addr", addr)
+		ready.SetReady(true)
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("serve error", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+	ready.SetReady(false)
+	logger.Info("shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.Error("shutdown error", "error", err)
+		os.Exit(1)
+	}
+	logger.Info("shutdown complete")
+}
@@ -0,0 +1,11 @@
+package server
+
+import "net/http"
+
+// handleIndex is framework-agnostic: every newRouter variant (net/http,
+// chi, gin, echo, fiber) registers it as a plain http.HandlerFunc via that
+// framework's adapter, so it only has to be written once.
+func handleIndex(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"message": "Hello World"}`))
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+// BuildInfo carries metadata injected at build time via
+// -ldflags "-X main.version=... -X main.commit=... -X main.buildDate=...".
+type BuildInfo struct {
+	Version   string
+	Commit    string
+	BuildDate string
+}
+
+// Readiness tracks whether the server is ready to receive traffic. It
+// starts false, is flipped true once startup completes, and should be
+// flipped back to false before shutdown begins so load balancers can
+// drain connections.
+type Readiness struct {
+	ready atomic.Bool
+}
+
+// SetReady marks the server ready or not ready.
+func (r *Readiness) SetReady(ready bool) {
+	r.ready.Store(ready)
+}
+
+func handleHealthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func handleReadyz(ready *Readiness) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !ready.ready.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func handleInfo(build BuildInfo, startTime time.Time) http.HandlerFunc {
+	hostname, _ := os.Hostname()
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"version":    build.Version,
+			"commit":     build.Commit,
+			"build_date": build.BuildDate,
+			"go_version": runtime.Version(),
+			"hostname":   hostname,
+			"start_time": startTime.UTC().Format(time.RFC3339),
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHealthzAlwaysOK(t *testing.T) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	handleHealthz(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+}
+
+func TestReadyzReflectsReadiness(t *testing.T) {
+	ready := &Readiness{}
+	handler := handleReadyz(ready)
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected status %d before ready, got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+
+	ready.SetReady(true)
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d once ready, got %d", http.StatusOK, rec.Code)
+	}
+}
+
+func TestInfoReturnsBuildMetadata(t *testing.T) {
+	build := BuildInfo{Version: "1.2.3", Commit: "abc123", BuildDate: "2026-07-28"}
+	handler := handleInfo(build, time.Now())
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/info", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("expected json content type, got %q", ct)
+	}
+
+	var body map[string]string
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if body["version"] != build.Version || body["commit"] != build.Commit || body["build_date"] != build.BuildDate {
+		t.Fatalf("expected build metadata %+v, got %v", build, body)
+	}
+}
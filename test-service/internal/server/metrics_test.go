@@ -0,0 +1,30 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestInstrumentRouteRecordsMetrics(t *testing.T) {
+	const route = "GET /test-metrics"
+	handler := instrumentRoute(route, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	before := testutil.ToFloat64(requestsTotal.WithLabelValues(http.MethodGet, route, "200"))
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/test-metrics", nil))
+
+	after := testutil.ToFloat64(requestsTotal.WithLabelValues(http.MethodGet, route, "200"))
+	if after != before+1 {
+		t.Fatalf("expected requestsTotal to increment by 1, got %v -> %v", before, after)
+	}
+
+	if n := testutil.CollectAndCount(requestDuration); n == 0 {
+		t.Fatal("expected requestDuration to have observations")
+	}
+}
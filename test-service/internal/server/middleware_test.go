@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestIDSetsHeaderAndContext(t *testing.T) {
+	var gotID string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotID = requestIDFromContext(r.Context())
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	requestID(next).ServeHTTP(rec, req)
+
+	if gotID == "" {
+		t.Fatal("expected request id in context, got empty string")
+	}
+	if rec.Header().Get("X-Request-Id") != gotID {
+		t.Fatalf("response header %q does not match context id %q", rec.Header().Get("X-Request-Id"), gotID)
+	}
+}
+
+func TestRecoverPanicReturns500(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	recoverPanic(logger, next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+}
+
+func TestAccessLogCapturesStatus(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	accessLog(logger, next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusTeapot {
+		t.Fatalf("expected status %d, got %d", http.StatusTeapot, rec.Code)
+	}
+}
+
+func TestStatusWriterWriteWithoutHeaderRecords200(t *testing.T) {
+	rec := httptest.NewRecorder()
+	sw := &statusWriter{ResponseWriter: rec, status: http.StatusOK}
+
+	if _, err := sw.Write([]byte("ok")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sw.status != http.StatusOK {
+		t.Fatalf("expected recorded status %d, got %d", http.StatusOK, sw.status)
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected response status %d, got %d", http.StatusOK, rec.Code)
+	}
+}
+
+func TestRequestIDFromContextEmptyWhenUnset(t *testing.T) {
+	if id := requestIDFromContext(context.Background()); id != "" {
+		t.Fatalf("expected empty request id, got %q", id)
+	}
+}
@@ -0,0 +1,35 @@
+//go:build chi
+
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// newRouter builds the route table for the chi variant. Route paths and
+// handlers are identical to the net/http variant; only the router type and
+// registration calls differ.
+func newRouter(ready *Readiness, build BuildInfo, startTime time.Time, metricsEnabled bool) http.Handler {
+	routes := map[string]http.HandlerFunc{
+		"/":        handleIndex,
+		"/healthz": handleHealthz,
+		"/readyz":  handleReadyz(ready),
+		"/info":    handleInfo(build, startTime),
+	}
+
+	r := chi.NewRouter()
+	for path, handler := range routes {
+		if metricsEnabled {
+			handler = instrumentRoute("GET "+path, handler)
+		}
+		r.Get(path, handler)
+	}
+	if metricsEnabled {
+		r.Get("/metrics", promhttp.Handler().ServeHTTP)
+	}
+	return r
+}
@@ -0,0 +1,36 @@
+//go:build echo
+
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// newRouter builds the route table for the echo variant. Handlers are
+// plain http.HandlerFunc, adapted onto echo via echo.WrapHandler so
+// they're shared verbatim with the other framework variants.
+func newRouter(ready *Readiness, build BuildInfo, startTime time.Time, metricsEnabled bool) http.Handler {
+	e := echo.New()
+	e.HideBanner = true
+
+	routes := map[string]http.HandlerFunc{
+		"/":        handleIndex,
+		"/healthz": handleHealthz,
+		"/readyz":  handleReadyz(ready),
+		"/info":    handleInfo(build, startTime),
+	}
+	for path, handler := range routes {
+		if metricsEnabled {
+			handler = instrumentRoute("GET "+path, handler)
+		}
+		e.GET(path, echo.WrapHandler(handler))
+	}
+	if metricsEnabled {
+		e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+	}
+	return e
+}
@@ -0,0 +1,38 @@
+//go:build fiber
+
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// newRouter builds the route table for the fiber variant. Fiber runs on
+// fasthttp rather than net/http, so handlers are adapted in with
+// adaptor.HTTPHandlerFunc/HTTPHandler, and the whole app is adapted back
+// out to an http.Handler with adaptor.FiberApp so it still plugs into the
+// *http.Server built in server.go like every other variant.
+func newRouter(ready *Readiness, build BuildInfo, startTime time.Time, metricsEnabled bool) http.Handler {
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+
+	routes := map[string]http.HandlerFunc{
+		"/":        handleIndex,
+		"/healthz": handleHealthz,
+		"/readyz":  handleReadyz(ready),
+		"/info":    handleInfo(build, startTime),
+	}
+	for path, handler := range routes {
+		if metricsEnabled {
+			handler = instrumentRoute("GET "+path, handler)
+		}
+		app.Get(path, adaptor.HTTPHandlerFunc(handler))
+	}
+	if metricsEnabled {
+		app.Get("/metrics", adaptor.HTTPHandler(promhttp.Handler()))
+	}
+	return adaptor.FiberApp(app)
+}
@@ -0,0 +1,36 @@
+//go:build gin
+
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// newRouter builds the route table for the gin variant. Handlers are
+// plain http.HandlerFunc, adapted onto gin via gin.WrapF/WrapH so they're
+// shared verbatim with the other framework variants.
+func newRouter(ready *Readiness, build BuildInfo, startTime time.Time, metricsEnabled bool) http.Handler {
+	gin.SetMode(gin.ReleaseMode)
+	r := gin.New()
+
+	routes := map[string]http.HandlerFunc{
+		"/":        handleIndex,
+		"/healthz": handleHealthz,
+		"/readyz":  handleReadyz(ready),
+		"/info":    handleInfo(build, startTime),
+	}
+	for path, handler := range routes {
+		if metricsEnabled {
+			handler = instrumentRoute("GET "+path, handler)
+		}
+		r.GET(path, gin.WrapF(handler))
+	}
+	if metricsEnabled {
+		r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	}
+	return r
+}
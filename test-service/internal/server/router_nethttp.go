@@ -0,0 +1,41 @@
+//go:build !chi && !gin && !echo && !fiber
+
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// newRouter builds the route table for the stdlib net/http variant (the
+// default framework), using Go 1.22+ ServeMux pattern syntax (method +
+// path). Build the chi/gin/echo/fiber variants instead with -tags chi,
+// -tags gin, -tags echo, or -tags fiber; each lives in its own
+// router_<framework>.go file with this same signature so server.go,
+// middleware.go, and health.go work identically regardless of which one is
+// compiled in. When metricsEnabled is true, every route is additionally
+// wrapped with instrumentRoute so it reports RED metrics under its own
+// registered pattern, and /metrics is mounted; when false, neither is
+// present.
+func newRouter(ready *Readiness, build BuildInfo, startTime time.Time, metricsEnabled bool) http.Handler {
+	routes := map[string]http.HandlerFunc{
+		"GET /":        handleIndex,
+		"GET /healthz": handleHealthz,
+		"GET /readyz":  handleReadyz(ready),
+		"GET /info":    handleInfo(build, startTime),
+	}
+
+	mux := http.NewServeMux()
+	for pattern, handler := range routes {
+		if metricsEnabled {
+			handler = instrumentRoute(pattern, handler)
+		}
+		mux.HandleFunc(pattern, handler)
+	}
+	if metricsEnabled {
+		mux.Handle("GET /metrics", promhttp.Handler())
+	}
+	return mux
+}
@@ -0,0 +1,34 @@
+// Package server builds the HTTP server for test-service.
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	readHeaderTimeout = 5 * time.Second
+	readTimeout       = 10 * time.Second
+	writeTimeout      = 10 * time.Second
+	idleTimeout       = 60 * time.Second
+)
+
+// New builds the HTTP server, wiring routes and middleware around it. The
+// returned Readiness lets the caller flip /readyz once startup completes
+// and flip it back before shutdown to drain connections. metricsEnabled
+// gates the opt-in /metrics endpoint and RED instrumentation.
+func New(addr string, logger *slog.Logger, build BuildInfo, metricsEnabled bool) (*http.Server, *Readiness) {
+	ready := &Readiness{}
+	handler := withMiddleware(newRouter(ready, build, time.Now(), metricsEnabled), logger)
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       readTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+	return srv, ready
+}